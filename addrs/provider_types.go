@@ -0,0 +1,30 @@
+package addrs
+
+// AddressedTypesAbs returns the distinct set of source provider types
+// referenced by the given provider configuration addresses, in the order
+// each type is first encountered. Multiple configurations for the same
+// provider type, such as aliased configurations, collapse to a single
+// entry.
+//
+// This is used to eagerly warm the provider schema cache and to drive
+// parallel GetSchema fan-out at the start of a graph walk, and is exposed
+// publicly so that external tooling, such as state migration scripts and
+// custom backends, can enumerate which providers a configuration, state,
+// or plan depends on without walking the graph themselves.
+func AddressedTypesAbs(providerConfigs []AbsProviderConfig) []Provider {
+	if len(providerConfigs) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(providerConfigs))
+	var ret []Provider
+	for _, pc := range providerConfigs {
+		key := pc.Provider.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		ret = append(ret, pc.Provider)
+	}
+	return ret
+}
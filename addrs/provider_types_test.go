@@ -0,0 +1,36 @@
+package addrs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func TestAddressedTypesAbs(t *testing.T) {
+	aws := addrs.NewDefaultProvider("aws")
+	azurerm := addrs.NewDefaultProvider("azurerm")
+
+	given := []addrs.AbsProviderConfig{
+		{Provider: aws},
+		{Provider: aws, Alias: "west"},
+		{Provider: azurerm},
+		{Provider: aws},
+	}
+
+	got := addrs.AddressedTypesAbs(given)
+	if len(got) != 2 {
+		t.Fatalf("wrong number of distinct provider types: got %d, want 2\n%#v", len(got), got)
+	}
+	if got[0] != aws {
+		t.Fatalf("wrong first entry: got %#v, want %#v", got[0], aws)
+	}
+	if got[1] != azurerm {
+		t.Fatalf("wrong second entry: got %#v, want %#v", got[1], azurerm)
+	}
+}
+
+func TestAddressedTypesAbs_empty(t *testing.T) {
+	if got := addrs.AddressedTypesAbs(nil); got != nil {
+		t.Fatalf("expected nil for no input, got %#v", got)
+	}
+}
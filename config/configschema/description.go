@@ -0,0 +1,22 @@
+package configschema
+
+// TextFormatting describes how a human-readable string, such as an
+// Attribute or Block description, is encoded, so that consumers know
+// whether it's safe to interpret using a renderer such as a Markdown
+// formatter.
+type TextFormatting string
+
+const (
+	// TextFormattingPlain indicates that a description contains only plain
+	// text, with nothing that needs to be escaped or interpreted by a
+	// renderer. It is not TextFormatting's zero value (that's ""), so an
+	// absent DescriptionKind must be normalized to TextFormattingPlain
+	// explicitly; see Attribute.EffectiveDescriptionKind and
+	// Block.EffectiveDescriptionKind.
+	TextFormattingPlain TextFormatting = "plain"
+
+	// TextFormattingMarkdown indicates that a description is formatted as
+	// Markdown and may be rendered as such by consumers such as
+	// documentation generators, editor integrations, and the CLI.
+	TextFormattingMarkdown TextFormatting = "markdown"
+)
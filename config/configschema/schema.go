@@ -0,0 +1,96 @@
+// Package configschema contains types for describing the schema of a
+// resource type, data source, or provider configuration, as implemented by
+// a Terraform provider plugin.
+package configschema
+
+import (
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Block represents a configuration block.
+//
+// "Block" here corresponds to the concept of a block in HCL. Blocks can be
+// recursively nested to create a structured schema tree.
+type Block struct {
+	// Attributes describes the attributes that may appear directly inside
+	// the block.
+	Attributes map[string]*Attribute
+
+	// BlockTypes describes the nested block types that may appear directly
+	// inside the block.
+	BlockTypes map[string]*NestedBlock
+
+	// Description and DescriptionKind together document the purpose of the
+	// block itself, using the same convention as Attribute.Description and
+	// Attribute.DescriptionKind.
+	Description     string
+	DescriptionKind TextFormatting
+}
+
+// EffectiveDescriptionKind returns DescriptionKind, substituting
+// TextFormattingPlain when it is unset. TextFormattingPlain is not itself
+// TextFormatting's zero value, so callers that need to tell plain text
+// apart from Markdown should use this instead of comparing DescriptionKind
+// directly; that lets schemas from providers built before this field
+// existed keep behaving as plain text.
+func (b *Block) EffectiveDescriptionKind() TextFormatting {
+	if b.DescriptionKind == "" {
+		return TextFormattingPlain
+	}
+	return b.DescriptionKind
+}
+
+// NestedBlock is a nested block within another block.
+type NestedBlock struct {
+	Block
+	Nesting NestingMode
+}
+
+// NestingMode is an enum describing the number of instances of a nested
+// block that are allowed within a particular parent block.
+type NestingMode int
+
+const (
+	nestingModeInvalid NestingMode = iota
+	NestingSingle
+	NestingGroup
+	NestingList
+	NestingSet
+	NestingMap
+)
+
+// Attribute represents a configuration attribute, within a block or as the
+// root of a resource type schema.
+type Attribute struct {
+	// Type is the cty type of the attribute's value.
+	Type cty.Type
+
+	// Description is a human-readable explanation of what the attribute is
+	// used for. DescriptionKind indicates how it should be interpreted.
+	Description string
+
+	// DescriptionKind indicates the formatting convention used for
+	// Description, so that consumers such as "terraform providers schema
+	// -json" and diagnostic renderers know whether to treat it as plain
+	// text or to run it through a Markdown renderer. It is left empty by
+	// schemas produced by providers built before this field was added; use
+	// EffectiveDescriptionKind rather than comparing DescriptionKind
+	// directly, since an empty string is not TextFormattingPlain itself.
+	DescriptionKind TextFormatting
+
+	Required  bool
+	Optional  bool
+	Computed  bool
+	Sensitive bool
+}
+
+// EffectiveDescriptionKind returns DescriptionKind, substituting
+// TextFormattingPlain when it is unset. See Block.EffectiveDescriptionKind
+// for why callers should use this instead of comparing DescriptionKind
+// directly.
+func (a *Attribute) EffectiveDescriptionKind() TextFormatting {
+	if a.DescriptionKind == "" {
+		return TextFormattingPlain
+	}
+	return a.DescriptionKind
+}
@@ -0,0 +1,62 @@
+package plans
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+)
+
+// Changes describes a set of changes that Terraform Core has proposed for
+// objects in a particular configuration, as produced by the plan walk.
+type Changes struct {
+	// Resources tracks the proposed change, if any, for each resource
+	// instance the plan touches, including one entry per deposed object
+	// being replaced.
+	Resources []*ResourceInstanceChangeSrc
+}
+
+// ResourceInstanceChangeSrc describes the proposed change for a single
+// resource instance, or one of its deposed objects, with the before/after
+// values still in their serialized form.
+type ResourceInstanceChangeSrc struct {
+	// Addr is the absolute address of the resource instance this change
+	// applies to.
+	Addr addrs.AbsResourceInstance
+
+	// DeposedKey is the identifier of the deposed object this change
+	// applies to, or states.NotDeposed if this change is for the instance's
+	// current object.
+	DeposedKey states.DeposedKey
+
+	// ProviderAddr is the absolute address of the provider configuration
+	// that produced this change.
+	ProviderAddr addrs.AbsProviderConfig
+
+	// Action describes the kind of change being proposed.
+	Action Action
+
+	// DeferredReason, when non-empty, means Terraform Core could not
+	// produce a concrete plan for this instance: Action is meaningless, and
+	// this change is only a placeholder that carries forward why, mirroring
+	// the reason recorded on the corresponding states.ResourceInstanceObject.
+	DeferredReason states.DeferredReason
+}
+
+// Action describes the kind of change being made to a resource instance.
+type Action rune
+
+const (
+	// NoOp means the resource instance is not changing.
+	NoOp Action = 0
+
+	// Create means the resource instance is being created.
+	Create Action = 'C'
+
+	// Read means the resource instance is a data source being read.
+	Read Action = 'R'
+
+	// Update means the resource instance is being updated in place.
+	Update Action = 'U'
+
+	// Delete means the resource instance is being destroyed.
+	Delete Action = 'D'
+)
@@ -0,0 +1,45 @@
+package plans
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// ProviderAddrs returns the provider configuration address of each resource
+// change in the given Changes, including duplicates. Callers that need the
+// deduplicated set of provider types should pass the result to
+// addrs.AddressedTypesAbs.
+func ProviderAddrs(changes *Changes) []addrs.AbsProviderConfig {
+	if changes == nil {
+		return nil
+	}
+
+	var ret []addrs.AbsProviderConfig
+	for _, rc := range changes.Resources {
+		ret = append(ret, rc.ProviderAddr)
+	}
+	return ret
+}
+
+// ProviderAddrs returns the provider configuration address of each resource
+// change gathered so far in the receiver's underlying Changes.
+//
+// It is the concurrency-safe counterpart of the package-level ProviderAddrs,
+// which terraform.DiffTransformer uses, combined with
+// addrs.AddressedTypesAbs, to learn which provider types a walk will need
+// before it starts, so they can eagerly warm the provider schema cache
+// instead of discovering providers lazily one vertex at a time.
+func (b *ChangesBuilder) ProviderAddrs() []addrs.AbsProviderConfig {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return ProviderAddrs(b.changes)
+}
+
+// ProviderTypes returns the deduplicated set of source provider types
+// referenced by the resource changes gathered so far in the receiver's
+// underlying Changes. It is a thin convenience wrapper around
+// addrs.AddressedTypesAbs; see ProviderAddrs for how callers use this to
+// warm the schema cache.
+func (b *ChangesBuilder) ProviderTypes() []addrs.Provider {
+	return addrs.AddressedTypesAbs(b.ProviderAddrs())
+}
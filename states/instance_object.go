@@ -1,11 +1,14 @@
 package states
 
 import (
+	"fmt"
+
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/tfdiags"
 )
 
 // ResourceInstanceObject is the local representation of a specific remote
@@ -64,6 +67,11 @@ type ResourceInstanceObject struct {
 	// it was updated.
 	Status ObjectStatus
 
+	// DeferredReason records why this object's create, update, or read was
+	// skipped rather than completed, when Status is ObjectDeferred. It is
+	// meaningless for any other status.
+	DeferredReason DeferredReason
+
 	// Dependencies is a set of other addresses in the same module which
 	// this instance depended on when the given attributes were evaluated.
 	// This is used to construct the dependency relationships for an object
@@ -86,6 +94,35 @@ const (
 	// update, or delete operation. Since it cannot be moved into the
 	// ObjectRead state, a tainted object must be replaced.
 	ObjectTainted ObjectStatus = 'T'
+
+	// ObjectDeferred is an object status representing an object whose
+	// create, update, or read was skipped because an upstream unknown
+	// value or a provider capability negotiation prevented Terraform from
+	// producing a concrete plan for it. A deferred object carries no
+	// usable attributes; see DeferredReason for why it was skipped.
+	ObjectDeferred ObjectStatus = 'D'
+)
+
+// DeferredReason describes why a ResourceInstanceObject was left in the
+// ObjectDeferred status instead of being planned normally.
+type DeferredReason string
+
+const (
+	// DeferredReasonProviderConfigUnknown means the object could not be
+	// planned because the provider configuration it depends on contains
+	// unknown values that are not resolved until a later operation.
+	DeferredReasonProviderConfigUnknown DeferredReason = "provider-config-unknown"
+
+	// DeferredReasonResourceConfigUnknown means the object could not be
+	// planned because its own configuration contains unknown values that
+	// the provider is not capable of planning against, such as an unknown
+	// value in a required argument that determines the resource's shape.
+	DeferredReasonResourceConfigUnknown DeferredReason = "resource-config-unknown"
+
+	// DeferredReasonAbsentPrereq means the object could not be planned
+	// because a prerequisite it depends on, such as another resource
+	// instance, is itself deferred or otherwise not yet available.
+	DeferredReasonAbsentPrereq DeferredReason = "absent-prereq"
 )
 
 // Value decodes the attributes of the receiver into an object value of the
@@ -95,7 +132,23 @@ const (
 // value may be misinterpreted or an error may be returned. To avoid problems,
 // this method should be used only after an object has been upgraded to the
 // current schema version and with the implied type of that schema.
+//
+// If the receiver's Status is ObjectDeferred, there is no concrete value to
+// decode, and Value returns a diagnostic explaining that the object's
+// create, update, or read was deferred and why. Callers that understand
+// deferred objects, such as a refresh walk, should check Status themselves
+// before calling Value rather than relying on this error.
 func (o *ResourceInstanceObject) Value(ty cty.Type) (cty.Value, error) {
+	if o.Status == ObjectDeferred {
+		var diags tfdiags.Diagnostics
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Resource instance object is deferred",
+			fmt.Sprintf("This object has no concrete value because it was deferred (%s). It can only be decoded in a context that understands deferred objects.", o.DeferredReason),
+		))
+		return cty.NilVal, diags.Err()
+	}
+
 	if o.AttrsFlat != nil {
 		// Legacy mode. We'll do our best to unpick this from the flatmap,
 		// but in practice a stored object should always be upgraded to
@@ -0,0 +1,43 @@
+package states
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestResourceInstanceObject_ValueDeferred(t *testing.T) {
+	obj := &ResourceInstanceObject{
+		Status:         ObjectDeferred,
+		DeferredReason: DeferredReasonProviderConfigUnknown,
+	}
+
+	_, err := obj.Value(cty.EmptyObject)
+	if err == nil {
+		t.Fatal("expected an error for a deferred object, got nil")
+	}
+	if !strings.Contains(err.Error(), "deferred") {
+		t.Fatalf("error does not mention deferral: %s", err)
+	}
+}
+
+func TestResourceInstanceObject_ValueReady(t *testing.T) {
+	ty := cty.Object(map[string]cty.Type{"id": cty.String})
+	val := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("foo")})
+
+	obj := &ResourceInstanceObject{
+		Status: ObjectReady,
+	}
+	if err := obj.SetValue(val, ty); err != nil {
+		t.Fatalf("SetValue failed: %s", err)
+	}
+
+	got, err := obj.Value(ty)
+	if err != nil {
+		t.Fatalf("Value failed: %s", err)
+	}
+	if !got.RawEquals(val) {
+		t.Fatalf("wrong value\ngot:  %#v\nwant: %#v", got, val)
+	}
+}
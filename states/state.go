@@ -0,0 +1,71 @@
+package states
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// State is the top-level representation of a Terraform state, tracking the
+// resources of every module instance in a configuration.
+type State struct {
+	// Modules is keyed on the string form of each module's addrs.ModuleInstance
+	// address, so that module instances can be looked up without walking
+	// the module tree.
+	Modules map[string]*Module
+}
+
+// NewState returns an empty, ready-to-use State.
+func NewState() *State {
+	return &State{
+		Modules: make(map[string]*Module),
+	}
+}
+
+// Module is the state for a particular module instance within the overall
+// State.
+type Module struct {
+	Addr      addrs.ModuleInstance
+	Resources map[string]*Resource
+}
+
+// Resource represents the state for all of the instances of a particular
+// resource address, within a particular Module.
+type Resource struct {
+	Addr addrs.Resource
+
+	// Instances is keyed on the string form of each instance's addrs.InstanceKey.
+	Instances map[string]*ResourceInstance
+
+	// ProviderConfig is the absolute address of the provider configuration
+	// that most recently managed each instance of this resource.
+	ProviderConfig addrs.AbsProviderConfig
+}
+
+// ResourceInstance is the state of a particular resource instance.
+type ResourceInstance struct {
+	Current *ResourceInstanceObject
+	Deposed map[DeposedKey]*ResourceInstanceObject
+}
+
+// ProviderAddrs returns the provider configuration address recorded against
+// each resource tracked in the state, including duplicates.
+func (s *State) ProviderAddrs() []addrs.AbsProviderConfig {
+	if s == nil {
+		return nil
+	}
+
+	var ret []addrs.AbsProviderConfig
+	for _, m := range s.Modules {
+		for _, r := range m.Resources {
+			ret = append(ret, r.ProviderConfig)
+		}
+	}
+	return ret
+}
+
+// ProviderTypes returns the deduplicated set of source provider types
+// referenced by the resources tracked in the state. It is a thin
+// convenience wrapper around addrs.AddressedTypesAbs, mirroring
+// plans.ChangesBuilder.ProviderTypes for state rather than a pending plan.
+func (s *State) ProviderTypes() []addrs.Provider {
+	return addrs.AddressedTypesAbs(s.ProviderAddrs())
+}
@@ -3,17 +3,46 @@ package terraform
 import (
 	"fmt"
 	"log"
+	"sync"
 
+	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/dag"
 	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/types"
 )
 
+// ProviderSchemaWarmer resolves a schema-fetching provider client for the
+// given provider type. DiffTransformer calls it, if set, once per distinct
+// provider type referenced by its Changes, purely to trigger a GetSchema
+// call; the returned Provider should already be wrapped in a
+// types.CachingProvider (or equivalent) so that call actually populates the
+// schema cache rather than being discarded.
+type ProviderSchemaWarmer func(addrs.Provider) (types.Provider, error)
+
 // DiffTransformer is a GraphTransformer that adds graph nodes representing
 // each of the resource changes described in the given Changes object.
+//
+// DiffTransformer itself only builds the graph; it does not evaluate
+// anything. Each node it adds, other than a NodeDeferredResourceInstance
+// (which calls no provider), is later responsible for populating the
+// Config, ProposedNewState, and ProviderMeta fields of the
+// types.PlanResourceChangeRequest/ApplyResourceChangeRequest/
+// ReadResourceRequest/ReadDataSourceRequest/ImportResourceStateRequest it
+// sends to the provider, once the graph walk reaches it and the module's
+// configuration has been evaluated.
 type DiffTransformer struct {
 	Concrete ConcreteResourceInstanceNodeFunc
 	Changes  *plans.Changes
+
+	// Providers, if set, is used to eagerly warm the provider schema cache
+	// before the graph walk proceeds: for each distinct provider type
+	// referenced by Changes, Transform calls it in its own goroutine and
+	// discards the result, relying only on the GetSchema call's side effect
+	// of populating the cache. If Providers is nil, Transform still
+	// computes the distinct provider types for logging purposes, but no
+	// warming happens.
+	Providers ProviderSchemaWarmer
 }
 
 func (t *DiffTransformer) Transform(g *Graph) error {
@@ -25,10 +54,33 @@ func (t *DiffTransformer) Transform(g *Graph) error {
 	// Go through all the modules in the diff.
 	log.Printf("[TRACE] DiffTransformer starting")
 
+	// Gather the distinct provider types referenced by this set of changes
+	// up front, rather than discovering them lazily vertex-by-vertex, so
+	// that we can eagerly warm the schema cache and fan out parallel
+	// GetSchema requests before the walk proceeds.
+	providerTypes := addrs.AddressedTypesAbs(plans.ProviderAddrs(t.Changes))
+	log.Printf("[TRACE] DiffTransformer: %d distinct provider types in changes", len(providerTypes))
+	t.warmProviderSchemas(providerTypes)
+
 	for _, rc := range t.Changes.Resources {
 		addr := rc.Addr
 		dk := rc.DeposedKey
 
+		if rc.DeferredReason != "" {
+			// The plan couldn't be produced for this instance, so rather
+			// than running it through the normal create/update/delete
+			// handling we represent it with a no-op vertex that just
+			// records why it was skipped.
+			node := &NodeDeferredResourceInstance{
+				NodeAbstractResourceInstance: NewNodeAbstractResourceInstance(addr),
+				DeposedKey:                   dk,
+				DeferredReason:               rc.DeferredReason,
+			}
+			log.Printf("[TRACE] DiffTransformer: %s is deferred (%s) and will be represented by %s", addr, rc.DeferredReason, dag.VertexName(node))
+			g.Add(node)
+			continue
+		}
+
 		switch rc.Action {
 		case plans.Delete:
 			// If we're destroying then we'll use a destroy node.
@@ -67,3 +119,46 @@ func (t *DiffTransformer) Transform(g *Graph) error {
 
 	return nil
 }
+
+// warmProviderSchemas fans out a GetSchema call, one goroutine per distinct
+// provider type, using t.Providers to resolve each one to a provider client.
+// It is a no-op if t.Providers is unset.
+func (t *DiffTransformer) warmProviderSchemas(providerTypes []addrs.Provider) {
+	if t.Providers == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, providerType := range providerTypes {
+		providerType := providerType
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			provider, err := t.Providers(providerType)
+			if err != nil {
+				log.Printf("[WARN] DiffTransformer: failed to warm schema cache for %s: %s", providerType, err)
+				return
+			}
+			provider.GetSchema()
+		}()
+	}
+	wg.Wait()
+}
+
+// NodeDeferredResourceInstance represents a resource instance whose plan
+// was deferred, meaning Terraform Core could not produce a concrete action
+// for it during this walk. It behaves as a no-op vertex: it participates in
+// the graph so that dependents see it, but performing it does nothing
+// beyond recording DeferredReason in the resulting plan.
+type NodeDeferredResourceInstance struct {
+	*NodeAbstractResourceInstance
+
+	// If this node is for a deposed object, DeposedKey is the identifier
+	// of that deposed object, or states.NotDeposed if this node is for the
+	// current object.
+	DeposedKey states.DeposedKey
+
+	// DeferredReason explains why this instance's plan was deferred.
+	DeferredReason states.DeferredReason
+}
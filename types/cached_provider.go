@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// CachingProvider wraps a Provider so that GetSchema is served from a
+// SchemaCache after the first call, rather than round-tripping through the
+// plugin protocol every time. This is the standard wrapper that provider
+// client constructors should use around the raw plugin client.
+type CachingProvider struct {
+	Provider
+
+	// Addr is the source address under which the wrapped provider's schema
+	// is looked up and stored in Cache.
+	Addr addrs.Provider
+
+	// Cache is the schema cache consulted by GetSchema. If nil, the
+	// process-wide cache returned by Cache() is used.
+	Cache *SchemaCache
+}
+
+// NewCachingProvider wraps the given provider so that its GetSchema results
+// are memoized under addr. Pass NewMockSchemaCache() for cache in tests
+// to avoid polluting the shared global cache; pass nil in production code to
+// use the global cache.
+func NewCachingProvider(addr addrs.Provider, provider Provider, cache *SchemaCache) *CachingProvider {
+	if cache == nil {
+		cache = Cache()
+	}
+	return &CachingProvider{
+		Provider: provider,
+		Addr:     addr,
+		Cache:    cache,
+	}
+}
+
+// GetSchema returns the wrapped provider's schema, consulting the cache
+// first and populating it from the underlying provider only on a miss.
+func (p *CachingProvider) GetSchema() GetSchemaResponse {
+	cache := p.Cache
+	if cache == nil {
+		cache = Cache()
+	}
+
+	if schema, ok := cache.Get(p.Addr); ok {
+		return schema
+	}
+
+	schema := p.Provider.GetSchema()
+	cache.Set(p.Addr, schema)
+	return schema
+}
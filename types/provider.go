@@ -10,6 +10,12 @@ import (
 // provider plugin.
 type Provider interface {
 	// GetSchema returns the complete schema for the provider.
+	//
+	// Schemas are expensive to fetch, so provider client constructors should
+	// wrap the raw plugin client in a CachingProvider, which consults
+	// types.Cache() (or a supplied SchemaCache) before making a protocol
+	// call and populates it with the result, so that repeated calls for the
+	// same provider address are served locally.
 	GetSchema() GetSchemaResponse
 
 	// ValidateProviderConfig allows the provider to validate the provider
@@ -64,6 +70,13 @@ type Provider interface {
 	ReadDataSource(ReadDataSourceRequest) ReadDataSourceResponse
 }
 
+// GetSchemaResponse describes a provider's schema.
+//
+// Each configschema.Attribute and configschema.Block carried within the
+// Provider, ResourceTypes, and DataSources blocks may set its
+// DescriptionKind to configschema.TextFormattingMarkdown to indicate that
+// its Description is Markdown rather than plain text; consumers should
+// preserve that kind rather than assuming plain text.
 type GetSchemaResponse struct {
 	Provider      *configschema.Block
 	ResourceTypes map[string]*configschema.Block
@@ -117,8 +130,20 @@ type ConfigureResponse struct {
 }
 
 type ReadResourceRequest struct {
-	Name       string
+	TypeName string
+
 	PriorState cty.Value
+
+	// Config is the full configuration for this resource, as bound in the
+	// module that declared it. Providers may use this to distinguish an
+	// attribute that the user left unset from one that was merely unknown
+	// at plan time.
+	Config cty.Value
+
+	// ProviderMeta is the provider-specific configuration supplied via a
+	// provider_meta block in the module that declared this resource, used
+	// for provider-level telemetry and other module-scoped metadata.
+	ProviderMeta cty.Value
 }
 
 type ReadResourceResponse struct {
@@ -127,9 +152,29 @@ type ReadResourceResponse struct {
 }
 
 type PlanResourceChangeRequest struct {
-	Name         string
+	TypeName string
+
 	PriorState   cty.Value
 	PriorPrivate []byte
+
+	// Config is the full configuration for this resource, as bound in the
+	// module that declared it. Providers may use this to distinguish an
+	// attribute that the user left unset (null in Config) from one whose
+	// value is merely unknown at plan time, which PriorState and
+	// ProposedNewState alone cannot tell apart.
+	Config cty.Value
+
+	// ProposedNewState is Terraform Core's proposal for the new state of
+	// the resource, produced by merging Config over PriorState following
+	// the usual computed-attribute rules. Providers implementing
+	// CustomizeDiff-style logic use this as the starting point for the
+	// plan they return.
+	ProposedNewState cty.Value
+
+	// ProviderMeta is the provider-specific configuration supplied via a
+	// provider_meta block in the module that declared this resource, used
+	// for provider-level telemetry and other module-scoped metadata.
+	ProviderMeta cty.Value
 }
 
 type PlanResourceChangeResponse struct {
@@ -139,10 +184,22 @@ type PlanResourceChangeResponse struct {
 }
 
 type ApplyResourceChangeRequest struct {
-	Name           string
+	TypeName string
+
 	PriorState     cty.Value
 	PlannedState   cty.Value
 	PlannedPrivate []byte
+
+	// Config is the full configuration for this resource, as bound in the
+	// module that declared it. Providers may use this to distinguish an
+	// attribute that the user left unset from one that was merely unknown
+	// at plan time.
+	Config cty.Value
+
+	// ProviderMeta is the provider-specific configuration supplied via a
+	// provider_meta block in the module that declared this resource, used
+	// for provider-level telemetry and other module-scoped metadata.
+	ProviderMeta cty.Value
 }
 
 type ApplyResourceChangeResponse struct {
@@ -152,8 +209,20 @@ type ApplyResourceChangeResponse struct {
 }
 
 type ImportResourceStateRequest struct {
-	Name string
-	ID   string
+	TypeName string
+	ID       string
+
+	// Config is the full configuration for this resource, as bound in the
+	// module that declared the import target. It is typically mostly
+	// unknown at import time, since the resource's arguments are still
+	// being discovered, but providers may use whatever is known to
+	// disambiguate the import.
+	Config cty.Value
+
+	// ProviderMeta is the provider-specific configuration supplied via a
+	// provider_meta block in the module that declared this resource, used
+	// for provider-level telemetry and other module-scoped metadata.
+	ProviderMeta cty.Value
 }
 
 type ImportResourceStateResponse struct {
@@ -162,7 +231,16 @@ type ImportResourceStateResponse struct {
 }
 
 type ReadDataSourceRequest struct {
-	Name string
+	TypeName string
+
+	// Config is the full configuration for this data source, as bound in
+	// the module that declared it.
+	Config cty.Value
+
+	// ProviderMeta is the provider-specific configuration supplied via a
+	// provider_meta block in the module that declared this data source,
+	// used for provider-level telemetry and other module-scoped metadata.
+	ProviderMeta cty.Value
 }
 
 type ReadDataSourceResponse struct {
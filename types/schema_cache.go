@@ -0,0 +1,59 @@
+package types
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// schemaCache is the global cache instance returned by Cache. It is
+// populated the first time a provider's schema is fetched and is shared by
+// all callers for the lifetime of the process.
+var schemaCache = &SchemaCache{
+	m: make(map[addrs.Provider]GetSchemaResponse),
+}
+
+// Cache returns the global provider schema cache.
+func Cache() *SchemaCache {
+	return schemaCache
+}
+
+// SchemaCache is a cache of provider schemas, keyed by the provider's
+// source address. Provider schemas are large and expensive to fetch (they
+// require a full round-trip through the plugin protocol), but they are
+// immutable for the lifetime of a configured provider instance, so we only
+// need to fetch each one once per process. Cache returns the process-wide
+// instance; NewMockSchemaCache returns an unshared one.
+//
+// SchemaCache is safe to use from multiple goroutines concurrently.
+type SchemaCache struct {
+	mu sync.RWMutex
+	m  map[addrs.Provider]GetSchemaResponse
+}
+
+// NewMockSchemaCache returns a SchemaCache that is not shared with any other
+// caller, for use in tests that would otherwise pollute the global cache.
+func NewMockSchemaCache() *SchemaCache {
+	return &SchemaCache{
+		m: make(map[addrs.Provider]GetSchemaResponse),
+	}
+}
+
+// Get returns the cached schema for the given provider, if any. The second
+// return value indicates whether a schema was found.
+func (c *SchemaCache) Get(provider addrs.Provider) (GetSchemaResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	schema, ok := c.m[provider]
+	return schema, ok
+}
+
+// Set stores the given schema in the cache for the given provider, replacing
+// any schema previously cached for that provider.
+func (c *SchemaCache) Set(provider addrs.Provider, schema GetSchemaResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.m[provider] = schema
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// countingSchemaProvider is a minimal Provider that only implements
+// GetSchema; the embedded nil Provider is never invoked by these tests.
+type countingSchemaProvider struct {
+	Provider
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (p *countingSchemaProvider) GetSchema() GetSchemaResponse {
+	p.mu.Lock()
+	p.calls++
+	p.mu.Unlock()
+	return GetSchemaResponse{}
+}
+
+func TestSchemaCache_GetSet(t *testing.T) {
+	cache := NewMockSchemaCache()
+	addr := addrs.NewDefaultProvider("test")
+
+	if _, ok := cache.Get(addr); ok {
+		t.Fatalf("expected no cached schema before Set")
+	}
+
+	cache.Set(addr, GetSchemaResponse{})
+
+	if _, ok := cache.Get(addr); !ok {
+		t.Fatalf("expected a cached schema after Set")
+	}
+}
+
+func TestSchemaCache_ConcurrentAccess(t *testing.T) {
+	cache := NewMockSchemaCache()
+	addr := addrs.NewDefaultProvider("test")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			cache.Set(addr, GetSchemaResponse{})
+		}()
+		go func() {
+			defer wg.Done()
+			cache.Get(addr)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCachingProvider_GetSchema(t *testing.T) {
+	addr := addrs.NewDefaultProvider("test")
+	underlying := &countingSchemaProvider{}
+	cached := NewCachingProvider(addr, underlying, NewMockSchemaCache())
+
+	cached.GetSchema()
+	cached.GetSchema()
+	cached.GetSchema()
+
+	if underlying.calls != 1 {
+		t.Fatalf("underlying GetSchema called %d times; want 1", underlying.calls)
+	}
+}